@@ -6,6 +6,9 @@ import (
 	"os"
 
 	"github.com/kurayami07734/copyright-notice-pre-commit/internal/config"
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/fixer"
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/git"
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/report"
 	"github.com/kurayami07734/copyright-notice-pre-commit/internal/scanner"
 )
 
@@ -49,6 +52,41 @@ func showUsage() {
 	fmt.Println("  copyright check src/")
 	fmt.Println("  copyright fix --auto-fix --company \"Acme Inc\" **/*.go")
 	fmt.Println("  copyright check --config .copyright.yaml .")
+	fmt.Println("  copyright check --staged")
+	fmt.Println("  copyright fix --since origin/main --auto-fix")
+	fmt.Println("  copyright check --format sarif . > results.sarif")
+}
+
+// resolveFiles determines which files a command should operate on: explicit
+// positional arguments, or the result of a git-aware selector when --staged
+// or --since was passed. staged and since are mutually exclusive; staged
+// takes precedence if both are set.
+func resolveFiles(positional []string, staged bool, since string) ([]string, error) {
+	if staged {
+		return git.StagedFiles()
+	}
+	if since != "" {
+		return git.ChangedSince(since)
+	}
+	return positional, nil
+}
+
+// filterFiles drops paths cfg.ShouldProcessFile excludes (e.g. via
+// .copyrightignore or Config.ExcludePatterns). Unlike fix, check has no
+// per-file Result to report the skip against, so the filtering happens
+// once up front instead of inside the scan. Directories are left untouched
+// since ShouldProcessFile only matches file patterns; Scanner.ScanFiles
+// still walks and expands them as before, and unreadable paths are left
+// for ScanFiles to report as stat errors rather than silently dropped here.
+func filterFiles(files []string, cfg *config.Config) []string {
+	kept := files[:0]
+	for _, f := range files {
+		stat, err := os.Stat(f)
+		if err != nil || stat.IsDir() || cfg.ShouldProcessFile(f) {
+			kept = append(kept, f)
+		}
+	}
+	return kept
 }
 
 func runCheck(args []string) {
@@ -56,9 +94,17 @@ func runCheck(args []string) {
 	configFile := fs.String("config", "", "Path to config file")
 	company := fs.String("company", "", "Company name")
 	verbose := fs.Bool("verbose", false, "Verbose output")
+	staged := fs.Bool("staged", false, "Only check files staged for commit (git diff --cached)")
+	since := fs.String("since", "", "Only check files changed since this git ref")
+	format := fs.String("format", string(report.FormatText), "Output format: text, json, sarif, junit")
 
 	fs.Parse(args)
-	files := fs.Args()
+
+	files, err := resolveFiles(fs.Args(), *staged, *since)
+	if err != nil {
+		fmt.Printf("Error resolving files from git: %v\n", err)
+		os.Exit(1)
+	}
 
 	if len(files) == 0 {
 		fmt.Println("No files specified")
@@ -74,34 +120,36 @@ func runCheck(args []string) {
 
 	// Override with command line flags
 	cfg.OverrideFromFlags(*company, "", false)
+	scanner.RegisterInterpreters(cfg.Interpreters)
+
+	files = filterFiles(files, cfg)
+	if len(files) == 0 {
+		fmt.Println("No files to check after applying excludes")
+		os.Exit(0)
+	}
 
 	// Create scanner
 	s := scanner.NewScanner(*verbose)
 
 	// Scan files
-	results, err := s.ScanFiles(files)
+	scanReport, err := s.ScanFiles(files)
+	if scanReport == nil {
+		fmt.Printf("Error scanning files: %v\n", err)
+		os.Exit(1)
+	}
 	if err != nil {
 		fmt.Printf("Error scanning files: %v\n", err)
 	}
 
-	// Report results
-	var missingCount, outdatedCount int
-	for _, result := range results {
-		if !result.HasCopyright {
-			missingCount++
-			fmt.Printf("MISSING: %s\n", result.Path)
-		} else if result.IsOutdated() {
-			outdatedCount++
-			fmt.Printf("OUTDATED: %s (year: %d)\n", result.Path, result.CopyrightYear)
-		} else {
-			fmt.Printf("OK: %s\n", result.Path)
-		}
+	output, err := report.Render(scanReport.Results, report.Format(*format), version, cfg.CompanyName)
+	if err != nil {
+		fmt.Printf("Error rendering report: %v\n", err)
+		os.Exit(1)
 	}
+	os.Stdout.Write(output)
 
-	fmt.Printf("Scanned %d files: %d missing copyright, %d outdated\n", len(results), missingCount, outdatedCount)
-
-	if missingCount > 0 || outdatedCount > 0 {
-		fmt.Println("Run with 'fix --auto-fix' to automatically fix issues")
+	missingCount, outdatedCount, wrongHolderCount := report.Counts(scanReport.Results, cfg.CompanyName)
+	if missingCount > 0 || outdatedCount > 0 || wrongHolderCount > 0 {
 		os.Exit(1)
 	}
 }
@@ -112,16 +160,58 @@ func runFix(args []string) {
 	company := fs.String("company", "", "Company name")
 	autoFix := fs.Bool("auto-fix", false, "Automatically fix issues")
 	dryRun := fs.Bool("dry-run", false, "Show what would be changed without making changes")
+	license := fs.String("license", "", "SPDX license identifier to use instead of notice_format (e.g. Apache-2.0, MIT)")
+	staged := fs.Bool("staged", false, "Only fix files staged for commit (git diff --cached)")
+	since := fs.String("since", "", "Only fix files changed since this git ref")
 
 	fs.Parse(args)
-	files := fs.Args()
 
-	fmt.Printf("Fixing copyright notices...\n")
-	fmt.Printf("Config: %s, Company: %s, Auto-fix: %t, Dry-run: %t\n", *configFile, *company, *autoFix, *dryRun)
-	fmt.Printf("Files: %v\n", files)
+	files, err := resolveFiles(fs.Args(), *staged, *since)
+	if err != nil {
+		fmt.Printf("Error resolving files from git: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(files) == 0 {
+		fmt.Println("No files specified")
+		os.Exit(1)
+	}
+
+	cfg, err := config.LoadConfig(*configFile)
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		os.Exit(1)
+	}
 
-	// TODO: Implement actual fix logic
-	fmt.Println("Fix functionality not yet implemented")
+	cfg.OverrideFromFlags(*company, "", *autoFix)
+	cfg.OverrideSPDXLicense(*license)
+	scanner.RegisterInterpreters(cfg.Interpreters)
+
+	f := fixer.New(cfg)
+
+	results, err := f.FixFiles(files, *dryRun)
+	if err != nil {
+		fmt.Printf("Error fixing files: %v\n", err)
+	}
+
+	var changedCount int
+	for _, result := range results {
+		if !result.Changed {
+			continue
+		}
+		changedCount++
+		if *dryRun {
+			fmt.Printf("WOULD FIX: %s\n%s", result.Path, result.Diff)
+		} else {
+			fmt.Printf("FIXED: %s\n", result.Path)
+		}
+	}
+
+	if *dryRun {
+		fmt.Printf("%d of %d files would be changed\n", changedCount, len(results))
+	} else {
+		fmt.Printf("Fixed %d of %d files\n", changedCount, len(results))
+	}
 }
 
 func runVersion() {
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bmatcuk/doublestar/v4"
 	"gopkg.in/yaml.v3"
 )
 
@@ -16,6 +17,14 @@ type Config struct {
 	AutoFix         bool     `yaml:"auto_fix"`
 	FilePatterns    []string `yaml:"file_patterns"`
 	ExcludePatterns []string `yaml:"exclude_patterns"`
+	// SPDXLicense, when set, selects a bundled SPDX license template
+	// (e.g. "Apache-2.0", "MIT") and causes the fixer to emit a short-form
+	// "SPDX-License-Identifier" header instead of NoticeFormat.
+	SPDXLicense string `yaml:"spdx_license"`
+	// Interpreters extends scanner.FileType.Interpreters, keyed by FileType
+	// name (e.g. "Python", "Shell"), so extensionless scripts using a
+	// custom shebang interpreter are still recognized.
+	Interpreters map[string][]string `yaml:"interpreters"`
 }
 
 // DefaultConfig returns a config with sensible defaults
@@ -59,6 +68,12 @@ func LoadConfig(configPath string) (*Config, error) {
 		}
 	}
 
+	ignorePatterns, err := loadCopyrightIgnore(".copyrightignore")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load .copyrightignore: %w", err)
+	}
+	config.ExcludePatterns = append(config.ExcludePatterns, ignorePatterns...)
+
 	return config, nil
 }
 
@@ -90,6 +105,30 @@ func loadFromFile(config *Config, path string) error {
 	return yaml.Unmarshal(data, config)
 }
 
+// loadCopyrightIgnore reads a .gitignore-style file of glob patterns (one
+// per line, "#" comments allowed) to append to ExcludePatterns. A missing
+// file is not an error.
+func loadCopyrightIgnore(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var patterns []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+
+	return patterns, nil
+}
+
 // OverrideFromFlags allows CLI flags to override config values
 func (c *Config) OverrideFromFlags(company, format string, autoFix bool) {
 	if company != "" {
@@ -103,6 +142,14 @@ func (c *Config) OverrideFromFlags(company, format string, autoFix bool) {
 	}
 }
 
+// OverrideSPDXLicense allows the --license CLI flag to override the
+// SPDX template selected in the config file.
+func (c *Config) OverrideSPDXLicense(spdxLicense string) {
+	if spdxLicense != "" {
+		c.SPDXLicense = spdxLicense
+	}
+}
+
 // GenerateNotice creates a copyright notice using the template
 func (c *Config) GenerateNotice() string {
 	notice := c.NoticeFormat
@@ -114,11 +161,18 @@ func (c *Config) GenerateNotice() string {
 
 // ShouldProcessFile checks if a file should be processed based on patterns
 func (c *Config) ShouldProcessFile(filePath string) bool {
+	slashPath := filepath.ToSlash(filePath)
+
 	// Check exclude patterns first
 	for _, pattern := range c.ExcludePatterns {
 		if matched, _ := filepath.Match(pattern, filePath); matched {
 			return false
 		}
+		// doublestar adds "**" support (e.g. "**/testdata/**") on top of
+		// filepath.Match's single-segment globs.
+		if matched, _ := doublestar.Match(filepath.ToSlash(pattern), slashPath); matched {
+			return false
+		}
 		// Also check if any part of the path matches
 		if strings.Contains(filePath, strings.TrimSuffix(pattern, "/")) {
 			return false
@@ -130,6 +184,9 @@ func (c *Config) ShouldProcessFile(filePath string) bool {
 		if matched, _ := filepath.Match(pattern, filepath.Base(filePath)); matched {
 			return true
 		}
+		if matched, _ := doublestar.Match(filepath.ToSlash(pattern), slashPath); matched {
+			return true
+		}
 	}
 
 	return false
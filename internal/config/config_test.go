@@ -0,0 +1,59 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestShouldProcessFileDoublestarExclude(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ExcludePatterns = append(cfg.ExcludePatterns, "**/testdata/**")
+
+	if cfg.ShouldProcessFile("internal/scanner/testdata/fixtures/sample.go") {
+		t.Error("ShouldProcessFile should exclude files under any testdata/ directory")
+	}
+}
+
+func TestShouldProcessFileDoublestarInclude(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.FilePatterns = []string{"cmd/**/*.go"}
+
+	if !cfg.ShouldProcessFile("cmd/copyright/main.go") {
+		t.Error("ShouldProcessFile should include files matched by a ** include pattern")
+	}
+}
+
+func TestLoadCopyrightIgnore(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".copyrightignore")
+	contents := "# comment\n**/vendor/**\n\nthird_party/*\n"
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+
+	patterns, err := loadCopyrightIgnore(path)
+	if err != nil {
+		t.Fatalf("loadCopyrightIgnore returned error: %v", err)
+	}
+
+	want := []string{"**/vendor/**", "third_party/*"}
+	if len(patterns) != len(want) {
+		t.Fatalf("loadCopyrightIgnore = %v, want %v", patterns, want)
+	}
+	for i, p := range patterns {
+		if p != want[i] {
+			t.Errorf("patterns[%d] = %q, want %q", i, p, want[i])
+		}
+	}
+}
+
+func TestLoadCopyrightIgnoreMissingFileIsNotAnError(t *testing.T) {
+	patterns, err := loadCopyrightIgnore(filepath.Join(t.TempDir(), ".copyrightignore"))
+	if err != nil {
+		t.Fatalf("missing .copyrightignore should not be an error, got %v", err)
+	}
+	if patterns != nil {
+		t.Errorf("expected nil patterns for missing file, got %v", patterns)
+	}
+}
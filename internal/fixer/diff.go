@@ -0,0 +1,89 @@
+package fixer
+
+import (
+	"fmt"
+	"strings"
+)
+
+const diffContextLines = 3
+
+// unifiedDiff renders a minimal unified diff between oldContent and
+// newContent. Fixer edits are always localized (an inserted header block or
+// a single rewritten year range), so a common-prefix/common-suffix diff
+// produces the same result a generic line-diff would, without the
+// complexity of one.
+func unifiedDiff(path, oldContent, newContent string) string {
+	oldLines := strings.Split(oldContent, "\n")
+	newLines := strings.Split(newContent, "\n")
+
+	prefix := commonPrefixLen(oldLines, newLines)
+	suffix := commonSuffixLen(oldLines[prefix:], newLines[prefix:])
+
+	oldEnd := len(oldLines) - suffix
+	newEnd := len(newLines) - suffix
+	if prefix == oldEnd && prefix == newEnd {
+		return ""
+	}
+
+	leadStart := maxInt(0, prefix-diffContextLines)
+	trailEnd := minInt(len(oldLines), oldEnd+diffContextLines)
+
+	oldStartLine := leadStart + 1
+	newStartLine := leadStart + 1
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- a/%s\n", path)
+	fmt.Fprintf(&b, "+++ b/%s\n", path)
+
+	oldCount := (prefix - leadStart) + (oldEnd - prefix) + (trailEnd - oldEnd)
+	newCount := (prefix - leadStart) + (newEnd - prefix) + (trailEnd - oldEnd)
+
+	fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", oldStartLine, oldCount, newStartLine, newCount)
+
+	for _, line := range oldLines[leadStart:prefix] {
+		fmt.Fprintf(&b, " %s\n", line)
+	}
+	for _, line := range oldLines[prefix:oldEnd] {
+		fmt.Fprintf(&b, "-%s\n", line)
+	}
+	for _, line := range newLines[prefix:newEnd] {
+		fmt.Fprintf(&b, "+%s\n", line)
+	}
+	for _, line := range oldLines[oldEnd:trailEnd] {
+		fmt.Fprintf(&b, " %s\n", line)
+	}
+
+	return b.String()
+}
+
+func commonPrefixLen(a, b []string) int {
+	n := minInt(len(a), len(b))
+	i := 0
+	for i < n && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+func commonSuffixLen(a, b []string) int {
+	n := minInt(len(a), len(b))
+	i := 0
+	for i < n && a[len(a)-1-i] == b[len(b)-1-i] {
+		i++
+	}
+	return i
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
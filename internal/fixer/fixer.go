@@ -0,0 +1,261 @@
+// Package fixer rewrites files to add or update copyright/license headers.
+//
+// It builds a language-appropriate comment block from Config.NoticeFormat
+// (or a bundled SPDX template), preserves shebang lines and Go build
+// constraints by inserting the header after them, and extends the year of
+// an existing copyright notice in place rather than duplicating it.
+package fixer
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/config"
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/scanner"
+)
+
+// Result describes the outcome of fixing a single file.
+type Result struct {
+	Path       string
+	Changed    bool
+	Diff       string
+	NewContent string
+}
+
+// Fixer adds or updates copyright headers in files.
+type Fixer struct {
+	cfg           *config.Config
+	scanner       *scanner.Scanner
+	yearRangeExpr *regexp.Regexp
+}
+
+// New creates a Fixer for the given configuration.
+func New(cfg *config.Config) *Fixer {
+	return &Fixer{
+		cfg:           cfg,
+		scanner:       scanner.NewScanner(false),
+		yearRangeExpr: regexp.MustCompile(`\b(19|20)\d{2}(-(19|20)\d{2})?\b`),
+	}
+}
+
+// FixFile brings a single file's header in line with the configured notice.
+// When dryRun is true the file is left untouched and Result.Diff holds a
+// unified diff of the change that would have been made.
+func (f *Fixer) FixFile(path string, dryRun bool) (*Result, error) {
+	result := &Result{Path: path}
+
+	if !f.cfg.ShouldProcessFile(path) {
+		return result, nil
+	}
+
+	original, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", path, err)
+	}
+
+	fileType := scanner.DetectFileTypeWithContent(path, original)
+	if fileType == nil {
+		return nil, fmt.Errorf("unsupported file type: %s", path)
+	}
+
+	info, err := f.scanner.ScanFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze file %s: %w", path, err)
+	}
+
+	updated := f.fixContent(string(original), *fileType, info)
+	if updated == string(original) {
+		return result, nil
+	}
+
+	result.Changed = true
+	result.NewContent = updated
+	result.Diff = unifiedDiff(path, string(original), updated)
+
+	if dryRun {
+		return result, nil
+	}
+
+	if err := os.WriteFile(path, []byte(updated), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write file %s: %w", path, err)
+	}
+
+	return result, nil
+}
+
+// FixFiles fixes each of paths, collecting per-file errors rather than
+// aborting on the first failure.
+func (f *Fixer) FixFiles(paths []string, dryRun bool) ([]*Result, error) {
+	var results []*Result
+	var errs []error
+
+	for _, path := range paths {
+		result, err := f.FixFile(path, dryRun)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		results = append(results, result)
+	}
+
+	if len(errs) > 0 {
+		return results, fmt.Errorf("encountered %d errors during fix", len(errs))
+	}
+
+	return results, nil
+}
+
+// fixContent is the pure transformation behind FixFile, split out so it can
+// be unit tested without touching the filesystem.
+func (f *Fixer) fixContent(content string, fileType scanner.FileType, info *scanner.FileInfo) string {
+	if info.HasCopyright {
+		return f.extendYearRange(content, info)
+	}
+	return f.insertNotice(content, fileType)
+}
+
+// extendYearRange rewrites the year token on an existing copyright line,
+// turning a single year into a range (2021 -> 2021-2025) or widening an
+// existing range, without touching the rest of the line.
+func (f *Fixer) extendYearRange(content string, info *scanner.FileInfo) string {
+	lines := strings.Split(content, "\n")
+	idx := info.LineNumber - 1
+	if idx < 0 || idx >= len(lines) {
+		return content
+	}
+
+	line := lines[idx]
+	match := f.yearRangeExpr.FindString(line)
+	if match == "" {
+		return content
+	}
+
+	extended := extendYearToken(match, time.Now().Year())
+	if extended == match {
+		return content
+	}
+
+	lines[idx] = strings.Replace(line, match, extended, 1)
+	return strings.Join(lines, "\n")
+}
+
+// extendYearToken widens a "2021" or "2019-2024" token so it covers newYear.
+func extendYearToken(token string, newYear int) string {
+	start, end := token, token
+	if parts := strings.SplitN(token, "-", 2); len(parts) == 2 {
+		start, end = parts[0], parts[1]
+	}
+
+	startYear, endYear := parseYear(start), parseYear(end)
+	if newYear <= endYear {
+		return token
+	}
+
+	return fmt.Sprintf("%d-%d", startYear, newYear)
+}
+
+func parseYear(s string) int {
+	var year int
+	fmt.Sscanf(s, "%d", &year)
+	return year
+}
+
+// insertNotice builds a comment block for the configured notice and inserts
+// it at the top of the file, after any shebang line or Go build constraints.
+func (f *Fixer) insertNotice(content string, fileType scanner.FileType) string {
+	trailingNewline := strings.HasSuffix(content, "\n") || content == ""
+
+	var lines []string
+	if body := strings.TrimSuffix(content, "\n"); body != "" {
+		lines = strings.Split(body, "\n")
+	}
+
+	insertAt := headerInsertIndex(lines, fileType)
+	block := commentBlock(fileType, f.noticeText())
+
+	result := make([]string, 0, len(lines)+len(block)+2)
+	result = append(result, lines[:insertAt]...)
+	if insertAt > 0 && strings.TrimSpace(lines[insertAt-1]) != "" {
+		result = append(result, "")
+	}
+	result = append(result, block...)
+	if insertAt < len(lines) && strings.TrimSpace(lines[insertAt]) != "" {
+		result = append(result, "")
+	}
+	result = append(result, lines[insertAt:]...)
+
+	joined := strings.Join(result, "\n")
+	if trailingNewline {
+		joined += "\n"
+	}
+	return joined
+}
+
+// headerInsertIndex returns the line index at which the header should be
+// inserted, skipping a leading shebang and, for Go files, leading
+// //go:build / // +build constraint lines.
+func headerInsertIndex(lines []string, fileType scanner.FileType) int {
+	idx := 0
+
+	if idx < len(lines) && strings.HasPrefix(lines[idx], "#!") {
+		idx++
+	}
+
+	if fileType.Name == "Go" {
+		for idx < len(lines) {
+			trimmed := strings.TrimSpace(lines[idx])
+			if strings.HasPrefix(trimmed, "//go:build") || strings.HasPrefix(trimmed, "// +build") {
+				idx++
+				continue
+			}
+			break
+		}
+	}
+
+	return idx
+}
+
+// noticeText returns the raw text to wrap in a comment block: the bundled
+// SPDX identifier line when Config.SPDXLicense names a key of
+// SPDXTemplates, otherwise (including an unknown identifier) the rendered
+// NoticeFormat.
+func (f *Fixer) noticeText() string {
+	if f.cfg.SPDXLicense != "" {
+		if tmpl, ok := SPDXTemplates[f.cfg.SPDXLicense]; ok {
+			return tmpl
+		}
+	}
+	return f.cfg.GenerateNotice()
+}
+
+// commentBlock wraps text in the comment syntax for fileType: a
+// CommentStart/CommentEnd block when the language has one (C, Java,
+// HTML/XML, ...), otherwise each line prefixed with LineComment.
+func commentBlock(fileType scanner.FileType, text string) []string {
+	textLines := strings.Split(text, "\n")
+
+	if fileType.CommentStart != "" && fileType.CommentEnd != "" {
+		block := make([]string, 0, len(textLines)+2)
+		block = append(block, fileType.CommentStart)
+		block = append(block, textLines...)
+		block = append(block, fileType.CommentEnd)
+		return block
+	}
+
+	if fileType.LineComment != "" {
+		block := make([]string, len(textLines))
+		for i, line := range textLines {
+			if line == "" {
+				block[i] = fileType.LineComment
+				continue
+			}
+			block[i] = fileType.LineComment + " " + line
+		}
+		return block
+	}
+
+	return textLines
+}
@@ -0,0 +1,159 @@
+package fixer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/config"
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/scanner"
+)
+
+func newTestFixer() *Fixer {
+	cfg := config.DefaultConfig()
+	cfg.CompanyName = "Acme Inc"
+	cfg.NoticeFormat = "Copyright (C) $year Acme Inc. All rights reserved."
+	return New(cfg)
+}
+
+func TestInsertNoticeGo(t *testing.T) {
+	f := newTestFixer()
+	ft := *scanner.DetectFileType("main.go")
+	content := "package main\n\nfunc main() {}\n"
+
+	got := f.insertNotice(content, ft)
+	want := fmt.Sprintf("// Copyright (C) %d Acme Inc. All rights reserved.\n\npackage main\n\nfunc main() {}\n", time.Now().Year())
+
+	if got != want {
+		t.Errorf("insertNotice(Go) = %q, want %q", got, want)
+	}
+}
+
+func TestInsertNoticePreservesGoBuildConstraint(t *testing.T) {
+	f := newTestFixer()
+	ft := *scanner.DetectFileType("build.go")
+	content := "//go:build linux\n\npackage build\n"
+
+	got := f.insertNotice(content, ft)
+	want := fmt.Sprintf("//go:build linux\n\n// Copyright (C) %d Acme Inc. All rights reserved.\n\npackage build\n", time.Now().Year())
+
+	if got != want {
+		t.Errorf("insertNotice(Go build tag) = %q, want %q", got, want)
+	}
+}
+
+func TestInsertNoticePreservesShebang(t *testing.T) {
+	f := newTestFixer()
+	ft := *scanner.DetectFileType("deploy.sh")
+	content := "#!/bin/bash\n\necho hi\n"
+
+	got := f.insertNotice(content, ft)
+	want := fmt.Sprintf("#!/bin/bash\n\n# Copyright (C) %d Acme Inc. All rights reserved.\n\necho hi\n", time.Now().Year())
+
+	if got != want {
+		t.Errorf("insertNotice(shebang) = %q, want %q", got, want)
+	}
+}
+
+func TestInsertNoticeBlockComment(t *testing.T) {
+	f := newTestFixer()
+	ft := *scanner.DetectFileType("Main.java")
+	content := "public class Main {}\n"
+
+	got := f.insertNotice(content, ft)
+	want := fmt.Sprintf("/*\nCopyright (C) %d Acme Inc. All rights reserved.\n*/\n\npublic class Main {}\n", time.Now().Year())
+
+	if got != want {
+		t.Errorf("insertNotice(Java) = %q, want %q", got, want)
+	}
+}
+
+func TestInsertNoticeHTML(t *testing.T) {
+	f := newTestFixer()
+	ft := *scanner.DetectFileType("index.html")
+	content := "<html></html>\n"
+
+	got := f.insertNotice(content, ft)
+	want := fmt.Sprintf("<!--\nCopyright (C) %d Acme Inc. All rights reserved.\n-->\n\n<html></html>\n", time.Now().Year())
+
+	if got != want {
+		t.Errorf("insertNotice(HTML) = %q, want %q", got, want)
+	}
+}
+
+func TestInsertNoticeLisp(t *testing.T) {
+	f := newTestFixer()
+	ft := *scanner.DetectFileType("init.el")
+	content := "(provide 'init)\n"
+
+	got := f.insertNotice(content, ft)
+	want := fmt.Sprintf("; Copyright (C) %d Acme Inc. All rights reserved.\n\n(provide 'init)\n", time.Now().Year())
+
+	if got != want {
+		t.Errorf("insertNotice(Lisp) = %q, want %q", got, want)
+	}
+}
+
+func TestInsertNoticeSPDX(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.SPDXLicense = "Apache-2.0"
+	f := New(cfg)
+	ft := *scanner.DetectFileType("main.go")
+
+	got := f.insertNotice("package main\n", ft)
+	want := "// SPDX-License-Identifier: Apache-2.0\n\npackage main\n"
+
+	if got != want {
+		t.Errorf("insertNotice(SPDX) = %q, want %q", got, want)
+	}
+}
+
+func TestExtendYearTokenSingleYear(t *testing.T) {
+	got := extendYearToken("2021", 2025)
+	if got != "2021-2025" {
+		t.Errorf("extendYearToken(2021, 2025) = %q, want 2021-2025", got)
+	}
+}
+
+func TestExtendYearTokenExistingRange(t *testing.T) {
+	got := extendYearToken("2019-2022", 2025)
+	if got != "2019-2025" {
+		t.Errorf("extendYearToken(2019-2022, 2025) = %q, want 2019-2025", got)
+	}
+}
+
+func TestExtendYearTokenAlreadyCurrent(t *testing.T) {
+	got := extendYearToken("2019-2025", 2025)
+	if got != "2019-2025" {
+		t.Errorf("extendYearToken(2019-2025, 2025) = %q, want unchanged 2019-2025", got)
+	}
+}
+
+func TestFixContentIdempotentWhenUpToDate(t *testing.T) {
+	f := newTestFixer()
+	ft := *scanner.DetectFileType("main.go")
+	year := time.Now().Year()
+	content := fmt.Sprintf("// Copyright (C) %d-%d Acme Inc. All rights reserved.\n\npackage main\n", year-2, year)
+
+	info := &scanner.FileInfo{HasCopyright: true, CopyrightYear: year, LineNumber: 1}
+	got := f.fixContent(content, ft, info)
+
+	if got != content {
+		t.Errorf("fixContent should be a no-op when already current, got %q", got)
+	}
+}
+
+func TestUnifiedDiffShowsInsertedHeader(t *testing.T) {
+	oldContent := "package main\n"
+	newContent := "// Copyright (C) 2026 Acme Inc. All rights reserved.\n\npackage main\n"
+
+	diff := unifiedDiff("main.go", oldContent, newContent)
+
+	if !strings.Contains(diff, "+// Copyright (C) 2026 Acme Inc. All rights reserved.") {
+		t.Errorf("diff missing inserted header line: %s", diff)
+	}
+	if !strings.Contains(diff, " package main") {
+		t.Errorf("diff missing unchanged context line: %s", diff)
+	}
+}
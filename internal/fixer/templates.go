@@ -0,0 +1,12 @@
+package fixer
+
+// SPDXTemplates maps a license identifier (as used in the `spdx_license`
+// config field / --license flag) to the short-form SPDX header line that
+// is inserted in place of Config.NoticeFormat.
+var SPDXTemplates = map[string]string{
+	"Apache-2.0":   "SPDX-License-Identifier: Apache-2.0",
+	"MIT":          "SPDX-License-Identifier: MIT",
+	"BSD-3-Clause": "SPDX-License-Identifier: BSD-3-Clause",
+	"MPL-2.0":      "SPDX-License-Identifier: MPL-2.0",
+	"GPL-3.0":      "SPDX-License-Identifier: GPL-3.0",
+}
@@ -0,0 +1,46 @@
+// Package git shells out to the git CLI to resolve the set of files a
+// pre-commit hook should care about, instead of walking the whole repo.
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// StagedFiles returns files staged for commit (added, copied, modified, or
+// renamed) per `git diff --cached --name-only --diff-filter=ACMR`.
+func StagedFiles() ([]string, error) {
+	return diffNameOnly("--cached")
+}
+
+// ChangedSince returns files that differ between ref and HEAD, using the
+// triple-dot (merge-base) form so commits upstream of ref that aren't on the
+// current branch don't show up as changes.
+func ChangedSince(ref string) ([]string, error) {
+	return diffNameOnly(fmt.Sprintf("%s...HEAD", ref))
+}
+
+func diffNameOnly(revSpec string) ([]string, error) {
+	args := []string{"diff", "--name-only", "--diff-filter=ACMR", revSpec}
+
+	cmd := exec.Command("git", args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+
+	var files []string
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			files = append(files, line)
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,39 @@
+package report
+
+import (
+	"encoding/json"
+
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/scanner"
+)
+
+// jsonFileResult is one entry in the JSON array: path, has_copyright, year,
+// notice, line_number, holder, spdx_id, status, as specified for CI
+// consumption.
+type jsonFileResult struct {
+	Path         string `json:"path"`
+	HasCopyright bool   `json:"has_copyright"`
+	Year         int    `json:"year"`
+	Notice       string `json:"notice"`
+	LineNumber   int    `json:"line_number"`
+	Holder       string `json:"holder,omitempty"`
+	SPDXID       string `json:"spdx_id,omitempty"`
+	Status       string `json:"status"`
+}
+
+// JSON renders results as a JSON array, one object per file.
+func JSON(results []*scanner.FileInfo, companyName string) ([]byte, error) {
+	out := make([]jsonFileResult, len(results))
+	for i, r := range results {
+		out[i] = jsonFileResult{
+			Path:         r.Path,
+			HasCopyright: r.HasCopyright,
+			Year:         r.CopyrightYear,
+			Notice:       r.CopyrightNotice,
+			LineNumber:   r.LineNumber,
+			Holder:       r.Holder,
+			SPDXID:       r.SPDXID,
+			Status:       status(r, companyName),
+		}
+	}
+	return json.MarshalIndent(out, "", "  ")
+}
@@ -0,0 +1,73 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/scanner"
+)
+
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnit renders results as a JUnit XML test suite, one testcase per file,
+// with a <failure> for anything missing, outdated, or held by a holder other
+// than companyName. This lets Jenkins and
+// GitLab surface copyright violations the same way they surface test
+// failures.
+func JUnit(results []*scanner.FileInfo, companyName string) ([]byte, error) {
+	suite := junitTestSuite{
+		Name:  "copyright-notice",
+		Tests: len(results),
+	}
+
+	for _, r := range results {
+		tc := junitTestCase{Name: r.Path, ClassName: "copyright"}
+
+		switch status(r, companyName) {
+		case "missing":
+			tc.Failure = &junitFailure{
+				Message: "missing copyright notice",
+				Text:    fmt.Sprintf("%s has no copyright notice", r.Path),
+			}
+			suite.Failures++
+		case "wrong_holder":
+			tc.Failure = &junitFailure{
+				Message: "wrong copyright holder",
+				Text:    fmt.Sprintf("%s copyright holder %q does not match %q", r.Path, r.Holder, companyName),
+			}
+			suite.Failures++
+		case "outdated":
+			tc.Failure = &junitFailure{
+				Message: "outdated copyright year",
+				Text:    fmt.Sprintf("%s copyright year %d is outdated", r.Path, r.CopyrightYear),
+			}
+			suite.Failures++
+		}
+
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+
+	out, err := xml.MarshalIndent(suite, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), out...), nil
+}
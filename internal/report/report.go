@@ -0,0 +1,102 @@
+// Package report renders scan results in the machine-readable formats CI
+// systems expect (JSON, SARIF, JUnit), plus the CLI's plain text output, all
+// from the same []*scanner.FileInfo slice so the scanner itself stays
+// format-agnostic.
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/scanner"
+)
+
+// Format selects how Render renders scan results.
+type Format string
+
+const (
+	FormatText  Format = "text"
+	FormatJSON  Format = "json"
+	FormatSARIF Format = "sarif"
+	FormatJUnit Format = "junit"
+)
+
+// Render renders results in the given format. toolVersion is embedded in
+// the SARIF driver metadata. companyName is used to flag files whose
+// detected copyright holder doesn't match the configured company.
+func Render(results []*scanner.FileInfo, format Format, toolVersion, companyName string) ([]byte, error) {
+	switch format {
+	case FormatText, "":
+		return Text(results, companyName), nil
+	case FormatJSON:
+		return JSON(results, companyName)
+	case FormatSARIF:
+		return SARIF(results, toolVersion, companyName)
+	case FormatJUnit:
+		return JUnit(results, companyName)
+	default:
+		return nil, fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+// status classifies a scanned file the same way across every format.
+func status(info *scanner.FileInfo, companyName string) string {
+	switch {
+	case !info.HasCopyright:
+		return "missing"
+	case info.HolderMismatch(companyName):
+		return "wrong_holder"
+	case info.IsOutdated():
+		return "outdated"
+	default:
+		return "ok"
+	}
+}
+
+// Text renders results in the plain-text form `check` prints to stdout: the
+// OK/MISSING/OUTDATED lines `check` has always printed, plus WRONG HOLDER
+// lines and a wrong-holder count in the summary.
+func Text(results []*scanner.FileInfo, companyName string) []byte {
+	var b strings.Builder
+	var missingCount, outdatedCount, wrongHolderCount int
+
+	for _, r := range results {
+		switch status(r, companyName) {
+		case "missing":
+			missingCount++
+			fmt.Fprintf(&b, "MISSING: %s\n", r.Path)
+		case "wrong_holder":
+			wrongHolderCount++
+			fmt.Fprintf(&b, "WRONG HOLDER: %s (found: %s)\n", r.Path, r.Holder)
+		case "outdated":
+			outdatedCount++
+			fmt.Fprintf(&b, "OUTDATED: %s (year: %d)\n", r.Path, r.CopyrightYear)
+		default:
+			fmt.Fprintf(&b, "OK: %s\n", r.Path)
+		}
+	}
+
+	fmt.Fprintf(&b, "Scanned %d files: %d missing copyright, %d outdated, %d wrong holder\n", len(results), missingCount, outdatedCount, wrongHolderCount)
+	if missingCount > 0 || outdatedCount > 0 || wrongHolderCount > 0 {
+		b.WriteString("Run with 'fix --auto-fix' to automatically fix issues\n")
+	}
+
+	return []byte(b.String())
+}
+
+// Counts returns how many results are missing a copyright notice, how many
+// are outdated, and how many have a holder that doesn't match companyName,
+// for callers that need the totals without rendering.
+func Counts(results []*scanner.FileInfo, companyName string) (missing, outdated, wrongHolder int) {
+	for _, r := range results {
+		switch status(r, companyName) {
+		case "missing":
+			missing++
+		case "outdated":
+			outdated++
+		case "wrong_holder":
+			wrongHolder++
+		}
+	}
+	return missing, outdated, wrongHolder
+}
@@ -0,0 +1,115 @@
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/scanner"
+)
+
+func sampleResults() []*scanner.FileInfo {
+	return []*scanner.FileInfo{
+		{Path: "ok.go", HasCopyright: true, CopyrightYear: 9999},
+		{Path: "missing.go"},
+		{Path: "outdated.go", HasCopyright: true, CopyrightYear: 2000, LineNumber: 3},
+		{Path: "wrongholder.go", HasCopyright: true, CopyrightYear: 9999, LineNumber: 1, Holder: "Other Corp"},
+	}
+}
+
+func TestJSONIncludesStatusPerFile(t *testing.T) {
+	out, err := JSON(sampleResults(), "Acme Inc")
+	if err != nil {
+		t.Fatalf("JSON returned error: %v", err)
+	}
+
+	var parsed []jsonFileResult
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		t.Fatalf("failed to unmarshal JSON output: %v", err)
+	}
+
+	if len(parsed) != 4 {
+		t.Fatalf("expected 4 entries, got %d", len(parsed))
+	}
+	if parsed[0].Status != "ok" || parsed[1].Status != "missing" || parsed[2].Status != "outdated" || parsed[3].Status != "wrong_holder" {
+		t.Errorf("unexpected statuses: %+v", parsed)
+	}
+}
+
+func TestSARIFOnlyReportsViolations(t *testing.T) {
+	out, err := SARIF(sampleResults(), "0.1.0", "Acme Inc")
+	if err != nil {
+		t.Fatalf("SARIF returned error: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(out, &log); err != nil {
+		t.Fatalf("failed to unmarshal SARIF output: %v", err)
+	}
+
+	results := log.Runs[0].Results
+	if len(results) != 3 {
+		t.Fatalf("expected 3 SARIF results (missing + outdated + wrong_holder), got %d", len(results))
+	}
+	if results[0].RuleID != "copyright/missing" || results[1].RuleID != "copyright/outdated" || results[2].RuleID != "copyright/wrong-holder" {
+		t.Errorf("unexpected rule IDs: %+v", results)
+	}
+}
+
+func TestSARIFCleanRunSerializesEmptyResultsArray(t *testing.T) {
+	clean := []*scanner.FileInfo{{Path: "ok.go", HasCopyright: true, CopyrightYear: 9999}}
+
+	out, err := SARIF(clean, "0.1.0", "Acme Inc")
+	if err != nil {
+		t.Fatalf("SARIF returned error: %v", err)
+	}
+
+	if strings.Contains(string(out), `"results": null`) {
+		t.Fatalf("SARIF output has null results, want an empty array: %s", out)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(out, &log); err != nil {
+		t.Fatalf("failed to unmarshal SARIF output: %v", err)
+	}
+	if log.Runs[0].Results == nil {
+		t.Error("expected Results to unmarshal as an empty slice, got nil")
+	}
+}
+
+func TestJUnitCountsFailures(t *testing.T) {
+	out, err := JUnit(sampleResults(), "Acme Inc")
+	if err != nil {
+		t.Fatalf("JUnit returned error: %v", err)
+	}
+
+	var suite junitTestSuite
+	if err := xml.Unmarshal(out, &suite); err != nil {
+		t.Fatalf("failed to unmarshal JUnit output: %v", err)
+	}
+
+	if suite.Tests != 4 || suite.Failures != 3 {
+		t.Errorf("suite = %+v, want tests=4 failures=3", suite)
+	}
+}
+
+func TestTextRendersStatusLinesAndSummary(t *testing.T) {
+	out := Text(sampleResults(), "Acme Inc")
+
+	if !strings.Contains(string(out), "OK: ok.go") ||
+		!strings.Contains(string(out), "MISSING: missing.go") ||
+		!strings.Contains(string(out), "OUTDATED: outdated.go (year: 2000)") ||
+		!strings.Contains(string(out), "WRONG HOLDER: wrongholder.go (found: Other Corp)") {
+		t.Errorf("Text output missing expected lines: %s", out)
+	}
+	if !strings.Contains(string(out), "Scanned 4 files: 1 missing copyright, 1 outdated, 1 wrong holder") {
+		t.Errorf("Text output missing summary line: %s", out)
+	}
+}
+
+func TestRenderUnknownFormat(t *testing.T) {
+	if _, err := Render(sampleResults(), Format("yaml"), "0.1.0", "Acme Inc"); err == nil {
+		t.Error("Render should reject an unknown format")
+	}
+}
@@ -0,0 +1,113 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kurayami07734/copyright-notice-pre-commit/internal/scanner"
+)
+
+// Minimal SARIF 2.1.0 object model: just enough of runs[].results[] for
+// GitHub Code Scanning to surface missing/outdated/wrong-holder copyright
+// notices.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// SARIF renders results as a SARIF 2.1.0 log with one result per
+// missing, outdated, or wrong-holder file. Files that are already OK produce
+// no result, same
+// as a linter reporting only violations.
+func SARIF(results []*scanner.FileInfo, toolVersion, companyName string) ([]byte, error) {
+	sarifResults := []sarifResult{}
+
+	for _, r := range results {
+		switch status(r, companyName) {
+		case "missing":
+			sarifResults = append(sarifResults, sarifResult{
+				RuleID:  "copyright/missing",
+				Level:   "error",
+				Message: sarifMessage{Text: fmt.Sprintf("%s has no copyright notice", r.Path)},
+				Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: r.Path},
+					Region:           sarifRegion{StartLine: 1},
+				}}},
+			})
+		case "wrong_holder":
+			sarifResults = append(sarifResults, sarifResult{
+				RuleID:  "copyright/wrong-holder",
+				Level:   "warning",
+				Message: sarifMessage{Text: fmt.Sprintf("%s copyright holder %q does not match %q", r.Path, r.Holder, companyName)},
+				Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: r.Path},
+					Region:           sarifRegion{StartLine: r.LineNumber},
+				}}},
+			})
+		case "outdated":
+			sarifResults = append(sarifResults, sarifResult{
+				RuleID:  "copyright/outdated",
+				Level:   "warning",
+				Message: sarifMessage{Text: fmt.Sprintf("%s copyright year %d is outdated", r.Path, r.CopyrightYear)},
+				Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: r.Path},
+					Region:           sarifRegion{StartLine: r.LineNumber},
+				}}},
+			})
+		}
+	}
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: sarifDriver{Name: "copyright-notice-pre-commit", Version: toolVersion}},
+			Results: sarifResults,
+		}},
+	}
+
+	return json.MarshalIndent(log, "", "  ")
+}
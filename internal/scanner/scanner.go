@@ -1,12 +1,15 @@
 package scanner
 
 import (
-	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -17,6 +20,28 @@ type FileInfo struct {
 	CopyrightYear   int
 	CopyrightNotice string
 	LineNumber      int
+	// Holder is the copyright holder text found after the year(s) on the
+	// copyright line, e.g. "The Vanadium Authors." for a line reading
+	// "Copyright 2015 The Vanadium Authors."
+	Holder string
+	// SPDXID is the license identifier from a standalone
+	// "SPDX-License-Identifier: <id>" line in the file's leading comment
+	// block, if present.
+	SPDXID string
+}
+
+// HolderMismatch reports whether the detected copyright holder differs from
+// expected. It returns false (no finding) when either side is empty, since
+// that means there's nothing to compare. The check is a substring match
+// rather than equality, since Holder also captures boilerplate that follows
+// the company name (e.g. "Acme Inc. All rights reserved.").
+func (info *FileInfo) HolderMismatch(expected string) bool {
+	if expected == "" || info.Holder == "" {
+		return false
+	}
+	holder := strings.ToLower(strings.TrimSpace(info.Holder))
+	want := strings.ToLower(strings.TrimSpace(expected))
+	return !strings.Contains(holder, want)
 }
 
 type FileType struct {
@@ -25,6 +50,13 @@ type FileType struct {
 	CommentStart string
 	CommentEnd   string
 	LineComment  string
+	// Interpreters lists the shebang interpreter names (the basename of the
+	// `#!` target, with `env`'s argument resolved) that identify this file
+	// type for extensionless scripts.
+	Interpreters []string
+	// Basenames lists well-known filenames (Makefile, Dockerfile, ...) that
+	// identify this file type regardless of extension.
+	Basenames []string
 }
 
 var supportedFileTypes = []FileType{
@@ -34,29 +66,75 @@ var supportedFileTypes = []FileType{
 		LineComment: "//",
 	},
 	{
-		Name:        "Python",
-		Extensions:  []string{".py"},
-		LineComment: "#",
+		Name:         "Python",
+		Extensions:   []string{".py"},
+		LineComment:  "#",
+		Interpreters: []string{"python", "python2", "python3"},
 	},
 	{
-		Name:        "JavaScript/TypeScript",
-		Extensions:  []string{".js", ".ts", ".jsx", ".tsx"},
-		LineComment: "//",
+		Name:         "JavaScript/TypeScript",
+		Extensions:   []string{".js", ".ts", ".jsx", ".tsx"},
+		LineComment:  "//",
+		Interpreters: []string{"node", "deno"},
 	},
 	{
-		Name:        "Java",
-		Extensions:  []string{".java"},
-		LineComment: "//",
+		Name:         "Java",
+		Extensions:   []string{".java"},
+		CommentStart: "/*",
+		CommentEnd:   "*/",
+		LineComment:  "//",
 	},
 	{
-		Name:        "C/C++",
-		Extensions:  []string{".c", ".cpp", ".cc", ".cxx", ".h", ".hpp"},
-		LineComment: "//",
+		Name:         "C/C++",
+		Extensions:   []string{".c", ".cpp", ".cc", ".cxx", ".h", ".hpp"},
+		CommentStart: "/*",
+		CommentEnd:   "*/",
+		LineComment:  "//",
+	},
+	{
+		Name:         "Shell",
+		Extensions:   []string{".sh", ".bash"},
+		LineComment:  "#",
+		Interpreters: []string{"sh", "bash", "zsh", "ksh"},
 	},
 	{
-		Name:        "Shell",
-		Extensions:  []string{".sh", ".bash"},
+		Name:         "HTML/XML",
+		Extensions:   []string{".html", ".htm", ".xml"},
+		CommentStart: "<!--",
+		CommentEnd:   "-->",
+	},
+	{
+		Name:        "Lisp",
+		Extensions:  []string{".lisp", ".cl", ".el", ".clj", ".scm"},
+		LineComment: ";",
+	},
+	{
+		Name:         "Ruby",
+		Extensions:   []string{".rb"},
+		LineComment:  "#",
+		Interpreters: []string{"ruby"},
+		Basenames:    []string{"Rakefile"},
+	},
+	{
+		Name:         "Perl",
+		Extensions:   []string{".pl", ".pm"},
+		LineComment:  "#",
+		Interpreters: []string{"perl"},
+	},
+	{
+		Name:        "Make",
 		LineComment: "#",
+		Basenames:   []string{"Makefile", "makefile", "GNUmakefile"},
+	},
+	{
+		Name:        "Docker",
+		LineComment: "#",
+		Basenames:   []string{"Dockerfile"},
+	},
+	{
+		Name:        "CMake",
+		LineComment: "#",
+		Basenames:   []string{"CMakeLists.txt"},
 	},
 }
 
@@ -64,30 +142,59 @@ var supportedFileTypes = []FileType{
 type Scanner struct {
 	copyrightRegex *regexp.Regexp
 	yearRegex      *regexp.Regexp
+	holderRegex    *regexp.Regexp
+	spdxRegex      *regexp.Regexp
 	verbose        bool
+	workers        int
+}
+
+// maxHeaderLines caps how many leading lines of a file are considered part
+// of its copyright/license header.
+const maxHeaderLines = 20
+
+// Option configures optional Scanner behavior.
+type Option func(*Scanner)
+
+// WithWorkers sets the number of goroutines ScanFiles fans file scans out
+// across. n <= 0 is ignored and the runtime.NumCPU() default is kept.
+func WithWorkers(n int) Option {
+	return func(s *Scanner) {
+		if n > 0 {
+			s.workers = n
+		}
+	}
 }
 
 // NewScanner creates a new scanner instance
-func NewScanner(verbose bool) *Scanner {
-	return &Scanner{
+func NewScanner(verbose bool, opts ...Option) *Scanner {
+	s := &Scanner{
 		copyrightRegex: regexp.MustCompile(`(?i)copyright\s*(\(c\))?\s*(\d{4})?`),
 		yearRegex:      regexp.MustCompile(`\b(19|20)\d{2}\b`),
+		holderRegex:    regexp.MustCompile(`(?i)copyright\s*(\(c\))?\s*((?:(?:19|20)\d{2}[\s,-]*)*)(.*)`),
+		spdxRegex:      regexp.MustCompile(`(?i)SPDX-License-Identifier:\s*(\S+)`),
 		verbose:        verbose,
+		workers:        runtime.NumCPU(),
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // ScanFile analyzes a file for copyright information
 func (s *Scanner) ScanFile(filePath string) (*FileInfo, error) {
-	fileType := s.detectFileType(filePath)
-	if fileType == nil {
-		return nil, fmt.Errorf("unsupported file type: %s", filePath)
-	}
-
 	content, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
 	}
 
+	fileType := DetectFileTypeWithContent(filePath, content)
+	if fileType == nil {
+		return nil, &UnsupportedFileTypeError{Path: filePath}
+	}
+
 	info := &FileInfo{
 		Path: filePath,
 		Type: *fileType,
@@ -97,68 +204,169 @@ func (s *Scanner) ScanFile(filePath string) (*FileInfo, error) {
 	return info, nil
 }
 
-// ScanFiles processes multiple files
-func (s *Scanner) ScanFiles(filePaths []string) ([]*FileInfo, error) {
-	var results []*FileInfo
-	var errors []error
+// ScanReport is the result of a ScanFiles call: the successfully scanned
+// files plus every per-file error encountered, so callers don't lose
+// context by reading a single collapsed count.
+type ScanReport struct {
+	Results  []*FileInfo
+	Errors   []error
+	Duration time.Duration
+}
 
-	for _, path := range filePaths {
-		// Handle directories
-		if stat, err := os.Stat(path); err == nil && stat.IsDir() {
-			dirFiles, err := s.scanDirectory(path)
-			if err != nil {
-				errors = append(errors, err)
-				continue
-			}
-			results = append(results, dirFiles...)
-		} else {
-			// Handle single file
-			info, err := s.ScanFile(path)
-			if err != nil {
-				errors = append(errors, err)
-				continue
-			}
-			results = append(results, info)
-		}
+// Err joins every per-file error into a single error, or nil if there were
+// none.
+func (r *ScanReport) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
 	}
+	return errors.Join(r.Errors...)
+}
+
+// scanJob is one file queued for the worker pool. fromDir marks files
+// discovered by walking a directory argument, as opposed to being named
+// directly on the command line.
+type scanJob struct {
+	path    string
+	fromDir bool
+}
+
+// ScanFiles scans files and directories concurrently using a worker pool
+// sized by WithWorkers (default runtime.NumCPU()).
+func (s *Scanner) ScanFiles(filePaths []string) (*ScanReport, error) {
+	start := time.Now()
+
+	jobs, err := s.collectJobs(filePaths)
+	if err != nil {
+		return nil, err
+	}
+
+	report := s.scanJobs(jobs)
+	sort.Slice(report.Results, func(i, j int) bool {
+		return report.Results[i].Path < report.Results[j].Path
+	})
+	report.Duration = time.Since(start)
 
-	if len(errors) > 0 {
-		return results, fmt.Errorf("encountered %d errors during scanning", len(errors))
+	if len(report.Errors) > 0 {
+		return report, fmt.Errorf("encountered %d errors during scanning", len(report.Errors))
 	}
 
-	return results, nil
+	return report, nil
 }
 
-// scanDirectory recursively scans a directory
-func (s *Scanner) scanDirectory(dirPath string) ([]*FileInfo, error) {
-	var results []*FileInfo
+// collectJobs expands directory arguments into their files, walking each
+// recursively, while files named directly are queued as-is.
+func (s *Scanner) collectJobs(filePaths []string) ([]scanJob, error) {
+	var jobs []scanJob
 
-	err := filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+	for _, path := range filePaths {
+		stat, err := os.Stat(path)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("failed to stat %s: %w", path, err)
 		}
 
-		if info.IsDir() {
+		if !stat.IsDir() {
+			jobs = append(jobs, scanJob{path: path})
+			continue
+		}
+
+		err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			jobs = append(jobs, scanJob{path: p, fromDir: true})
 			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
+	}
+
+	return jobs, nil
+}
+
+// scanJobs fans jobs out across s.workers goroutines and collects their
+// results. Files discovered via a directory walk that turn out to be an
+// unsupported type are skipped silently, matching a plain `git ls-files |
+// grep`-style walk; files named directly on the command line are reported
+// as errors, since the caller explicitly asked for them.
+func (s *Scanner) scanJobs(jobs []scanJob) *ScanReport {
+	type jobResult struct {
+		info    *FileInfo
+		err     error
+		fromDir bool
+	}
+
+	jobCh := make(chan scanJob)
+	resultCh := make(chan jobResult)
+
+	workers := s.workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobCh {
+				info, err := s.ScanFile(job.path)
+				resultCh <- jobResult{info: info, err: err, fromDir: job.fromDir}
+			}
+		}()
+	}
 
-		if s.detectFileType(path) != nil {
-			fileInfo, scanErr := s.ScanFile(path)
-			if scanErr != nil {
-				fmt.Printf("Warning: failed to scan %s: %v\n", path, scanErr)
-			} else {
-				results = append(results, fileInfo)
+	go func() {
+		for _, job := range jobs {
+			jobCh <- job
+		}
+		close(jobCh)
+	}()
+
+	go func() {
+		wg.Wait()
+		close(resultCh)
+	}()
+
+	report := &ScanReport{}
+	for res := range resultCh {
+		if res.err != nil {
+			var unsupported *UnsupportedFileTypeError
+			if res.fromDir && errors.As(res.err, &unsupported) {
+				continue
 			}
+			report.Errors = append(report.Errors, res.err)
+			continue
 		}
+		report.Results = append(report.Results, res.info)
+	}
 
-		return nil
-	})
+	return report
+}
 
-	return results, err
+// UnsupportedFileTypeError indicates a file whose type could not be
+// determined from its extension, basename, or shebang line. Files
+// discovered via a directory walk treat this as "silently skip", not a
+// failure worth warning about.
+type UnsupportedFileTypeError struct {
+	Path string
 }
 
-// detectFileType determines the file type based on extension
-func (s *Scanner) detectFileType(filePath string) *FileType {
+func (e *UnsupportedFileTypeError) Error() string {
+	return fmt.Sprintf("unsupported file type: %s", e.Path)
+}
+
+// DetectFileType determines the file type for a path based on its extension
+// or well-known basename. It is exported so other packages (e.g.
+// internal/fixer) can resolve comment syntax without constructing a Scanner.
+func DetectFileType(filePath string) *FileType {
+	if fileType := detectFileTypeByBasename(filePath); fileType != nil {
+		return fileType
+	}
+
 	ext := strings.ToLower(filepath.Ext(filePath))
 
 	for _, fileType := range supportedFileTypes {
@@ -172,45 +380,188 @@ func (s *Scanner) detectFileType(filePath string) *FileType {
 	return nil
 }
 
-// analyzeCopyright looks for copyright notices in file content
-func (s *Scanner) analyzeCopyright(content string, info *FileInfo) {
-	scanner := bufio.NewScanner(strings.NewReader(content))
-	lineNum := 0
+// DetectFileTypeWithContent determines the file type for a path, falling
+// back to parsing a leading shebang line (`#!/usr/bin/env python3`) from
+// head when the extension and basename don't match anything. head only
+// needs to contain the first line of the file; callers that already have
+// the full content in memory should pass it to avoid a second read.
+func DetectFileTypeWithContent(path string, head []byte) *FileType {
+	if fileType := DetectFileType(path); fileType != nil {
+		return fileType
+	}
+
+	return detectFileTypeByShebang(head)
+}
 
-	// Only check first 20 lines for copyright
-	for scanner.Scan() && lineNum < 20 {
-		lineNum++
-		line := strings.TrimSpace(scanner.Text())
+// detectFileTypeByBasename matches well-known filenames (Makefile,
+// Dockerfile, CMakeLists.txt, ...) that have no useful extension.
+func detectFileTypeByBasename(filePath string) *FileType {
+	base := filepath.Base(filePath)
 
-		// Skip empty lines
+	for _, fileType := range supportedFileTypes {
+		for _, name := range fileType.Basenames {
+			if base == name {
+				return &fileType
+			}
+		}
+	}
+
+	return nil
+}
+
+// RegisterInterpreters extends supportedFileTypes with additional shebang
+// interpreter names, keyed by FileType.Name (e.g. "Python", "Shell"), so
+// users can recognize extensionless scripts the bundled registry doesn't
+// already cover (e.g. a company-specific "mypython" wrapper). Names that
+// don't match an existing FileType are ignored. Callers should register
+// before any detection happens, since supportedFileTypes is shared
+// package-level state.
+func RegisterInterpreters(extra map[string][]string) {
+	for i := range supportedFileTypes {
+		fileType := &supportedFileTypes[i]
+		fileType.Interpreters = append(fileType.Interpreters, extra[fileType.Name]...)
+	}
+}
+
+// detectFileTypeByShebang parses the `#!` interpreter directive on the
+// first line of head and matches it against each FileType.Interpreters.
+func detectFileTypeByShebang(head []byte) *FileType {
+	firstLine := head
+	if idx := strings.IndexByte(string(head), '\n'); idx >= 0 {
+		firstLine = head[:idx]
+	}
+
+	line := strings.TrimSpace(string(firstLine))
+	if !strings.HasPrefix(line, "#!") {
+		return nil
+	}
+
+	fields := strings.Fields(strings.TrimPrefix(line, "#!"))
+	if len(fields) == 0 {
+		return nil
+	}
+
+	interpreter := filepath.Base(fields[0])
+	if interpreter == "env" && len(fields) > 1 {
+		interpreter = fields[1]
+	}
+
+	for _, fileType := range supportedFileTypes {
+		for _, candidate := range fileType.Interpreters {
+			if candidate == interpreter {
+				return &fileType
+			}
+		}
+	}
+
+	return nil
+}
+
+// analyzeCopyright looks for copyright and SPDX information in a file's
+// leading comment block, which may span multiple lines
+// (e.g. a /* ... */ block, or several contiguous "//" lines).
+func (s *Scanner) analyzeCopyright(content string, info *FileInfo) {
+	lines := strings.Split(content, "\n")
+	block, startLine := s.headerBlock(lines, info.Type)
+
+	for i, rawLine := range block {
+		line := strings.TrimSpace(rawLine)
 		if line == "" {
 			continue
 		}
 
-		// Remove comment markers
 		cleanLine := s.removeCommentMarkers(line, info.Type)
 
-		// Debug: Print what we're checking (remove this later)
 		if s.verbose && strings.Contains(strings.ToLower(cleanLine), "copyright") {
-			fmt.Printf("DEBUG: Found 'copyright' in line %d: '%s' -> cleaned: '%s'\n", lineNum, line, cleanLine)
+			fmt.Printf("DEBUG: Found 'copyright' in line %d: '%s' -> cleaned: '%s'\n", startLine+i, line, cleanLine)
+		}
+
+		if info.SPDXID == "" {
+			if m := s.spdxRegex.FindStringSubmatch(cleanLine); m != nil {
+				info.SPDXID = m[1]
+			}
+		}
+
+		if info.HasCopyright || !s.copyrightRegex.MatchString(cleanLine) {
+			continue
+		}
+
+		info.HasCopyright = true
+		info.CopyrightNotice = line
+		info.LineNumber = startLine + i
+
+		// Take the max of every year found on the line, covering both a
+		// single year ("2021") and a range or list ("2019-2024",
+		// "2019, 2022, 2024").
+		for _, yearStr := range s.yearRegex.FindAllString(cleanLine, -1) {
+			if year := parseInt(yearStr); year > info.CopyrightYear {
+				info.CopyrightYear = year
+			}
 		}
 
-		// Check if this line contains copyright
-		if s.copyrightRegex.MatchString(cleanLine) {
-			info.HasCopyright = true
-			info.CopyrightNotice = line
-			info.LineNumber = lineNum
+		if m := s.holderRegex.FindStringSubmatch(cleanLine); m != nil {
+			info.Holder = strings.TrimSpace(m[3])
+		}
+	}
+}
+
+// headerBlock returns the contiguous leading comment block of lines (after
+// any shebang and leading blank lines), along with the 1-indexed line
+// number of its first line. For CommentStart/CommentEnd languages this is
+// the text between the first /* and its matching */; for line-comment
+// languages it's the run of contiguous comment lines at the top of the
+// file. At most maxHeaderLines lines are considered.
+func (s *Scanner) headerBlock(lines []string, fileType FileType) ([]string, int) {
+	start := 0
+	if start < len(lines) && strings.HasPrefix(lines[start], "#!") {
+		start++
+	}
+	for start < len(lines) && strings.TrimSpace(lines[start]) == "" {
+		start++
+	}
+
+	limit := start + maxHeaderLines
+	if limit > len(lines) {
+		limit = len(lines)
+	}
 
-			// Try to extract year
-			if years := s.yearRegex.FindAllString(cleanLine, -1); len(years) > 0 {
-				// Use the last year found (most recent)
-				if year := parseInt(years[len(years)-1]); year > 0 {
-					info.CopyrightYear = year
-				}
+	if fileType.CommentStart != "" && fileType.CommentEnd != "" {
+		blockStart := -1
+		for i := start; i < limit; i++ {
+			if strings.Contains(lines[i], fileType.CommentStart) {
+				blockStart = i
+				break
 			}
+		}
+		if blockStart == -1 {
+			return nil, 0
+		}
+
+		blockEnd := blockStart
+		for i := blockStart; i < limit; i++ {
+			blockEnd = i
+			if strings.Contains(lines[i], fileType.CommentEnd) {
+				break
+			}
+		}
+
+		return lines[blockStart : blockEnd+1], blockStart + 1
+	}
+
+	if fileType.LineComment == "" {
+		return nil, 0
+	}
+
+	end := start
+	for end < limit {
+		trimmed := strings.TrimSpace(lines[end])
+		if trimmed != "" && !strings.HasPrefix(trimmed, fileType.LineComment) {
 			break
 		}
+		end++
 	}
+
+	return lines[start:end], start + 1
 }
 
 // removeCommentMarkers strips comment syntax from a line
@@ -0,0 +1,62 @@
+package scanner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// synthTree writes fileCount trivial .go files to a fresh temp directory and
+// returns its path, for benchmarking the worker pool against a large
+// monorepo-shaped input.
+func synthTree(b *testing.B, fileCount int) string {
+	b.Helper()
+	dir := b.TempDir()
+
+	for i := 0; i < fileCount; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("file_%d.go", i))
+		if err := os.WriteFile(path, []byte("package bench\n"), 0644); err != nil {
+			b.Fatalf("failed to write fixture %s: %v", path, err)
+		}
+	}
+
+	return dir
+}
+
+// BenchmarkScanFiles_10kFiles measures throughput of the default
+// (runtime.NumCPU()-worker) pool over a synthetic 10k-file tree.
+func BenchmarkScanFiles_10kFiles(b *testing.B) {
+	dir := synthTree(b, 10000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s := NewScanner(false)
+		report, err := s.ScanFiles([]string{dir})
+		if err != nil {
+			b.Fatalf("ScanFiles returned error: %v", err)
+		}
+		if len(report.Results) != 10000 {
+			b.Fatalf("expected 10000 results, got %d", len(report.Results))
+		}
+	}
+}
+
+// BenchmarkScanFiles_10kFiles_SingleWorker is the serial baseline: run with
+// -bench alongside BenchmarkScanFiles_10kFiles to see the worker pool's
+// speedup on a multi-core machine.
+func BenchmarkScanFiles_10kFiles_SingleWorker(b *testing.B) {
+	dir := synthTree(b, 10000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s := NewScanner(false, WithWorkers(1))
+		report, err := s.ScanFiles([]string{dir})
+		if err != nil {
+			b.Fatalf("ScanFiles returned error: %v", err)
+		}
+		if len(report.Results) != 10000 {
+			b.Fatalf("expected 10000 results, got %d", len(report.Results))
+		}
+	}
+}
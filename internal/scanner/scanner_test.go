@@ -0,0 +1,194 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDetectFileTypeByBasename(t *testing.T) {
+	cases := map[string]string{
+		"Makefile":       "Make",
+		"GNUmakefile":    "Make",
+		"Dockerfile":     "Docker",
+		"CMakeLists.txt": "CMake",
+		"Rakefile":       "Ruby",
+	}
+
+	for path, want := range cases {
+		got := DetectFileType(path)
+		if got == nil || got.Name != want {
+			t.Errorf("DetectFileType(%q) = %v, want %q", path, got, want)
+		}
+	}
+}
+
+func TestDetectFileTypeWithContentShebang(t *testing.T) {
+	cases := []struct {
+		name string
+		head string
+		want string
+	}{
+		{"env python3", "#!/usr/bin/env python3\n", "Python"},
+		{"bin bash", "#!/bin/bash\n", "Shell"},
+		{"env node", "#!/usr/bin/env node\n", "JavaScript/TypeScript"},
+		{"env ruby", "#!/usr/bin/env ruby\n", "Ruby"},
+	}
+
+	for _, tc := range cases {
+		got := DetectFileTypeWithContent("bin/deploy", []byte(tc.head))
+		if got == nil || got.Name != tc.want {
+			t.Errorf("%s: DetectFileTypeWithContent(%q) = %v, want %q", tc.name, tc.head, got, tc.want)
+		}
+	}
+}
+
+func TestDetectFileTypeWithContentNoShebang(t *testing.T) {
+	got := DetectFileTypeWithContent("bin/deploy", []byte("just some plain text\n"))
+	if got != nil {
+		t.Errorf("DetectFileTypeWithContent(no shebang) = %v, want nil", got)
+	}
+}
+
+func TestDetectFileTypeExtensionTakesPriorityOverShebang(t *testing.T) {
+	got := DetectFileTypeWithContent("script.py", []byte("#!/bin/bash\n"))
+	if got == nil || got.Name != "Python" {
+		t.Errorf("DetectFileTypeWithContent(script.py) = %v, want Python", got)
+	}
+}
+
+func TestRegisterInterpretersExtendsShebangDetection(t *testing.T) {
+	RegisterInterpreters(map[string][]string{"Python": {"mypython"}})
+	t.Cleanup(func() {
+		for i := range supportedFileTypes {
+			if supportedFileTypes[i].Name != "Python" {
+				continue
+			}
+			interpreters := supportedFileTypes[i].Interpreters
+			supportedFileTypes[i].Interpreters = interpreters[:len(interpreters)-1]
+		}
+	})
+
+	got := DetectFileTypeWithContent("bin/deploy", []byte("#!/usr/bin/env mypython\n"))
+	if got == nil || got.Name != "Python" {
+		t.Errorf("DetectFileTypeWithContent(mypython) = %v, want Python", got)
+	}
+}
+
+func TestScanFilesSkipsUnsupportedFilesFoundInDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a.go"), "package a\n")
+	writeFile(t, filepath.Join(dir, "README.md"), "# unrelated\n")
+
+	s := NewScanner(false)
+	report, err := s.ScanFiles([]string{dir})
+	if err != nil {
+		t.Fatalf("ScanFiles returned error: %v", err)
+	}
+	if len(report.Results) != 1 || report.Results[0].Path != filepath.Join(dir, "a.go") {
+		t.Errorf("ScanFiles(dir) results = %v, want only a.go", report.Results)
+	}
+}
+
+func TestScanFilesReportsUnsupportedFileNamedDirectly(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "README.md")
+	writeFile(t, path, "# unrelated\n")
+
+	s := NewScanner(false)
+	report, err := s.ScanFiles([]string{path})
+	if err == nil {
+		t.Fatal("expected an error for an explicitly named unsupported file")
+	}
+	if len(report.Errors) != 1 {
+		t.Errorf("report.Errors = %v, want exactly one error", report.Errors)
+	}
+}
+
+func TestAnalyzeCopyrightMultiLineLineComments(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	writeFile(t, path, "// Copyright 2015 The Vanadium Authors.\n// Use of this source code is governed by a BSD-style\n// license that can be found in the LICENSE file.\n\npackage main\n")
+
+	s := NewScanner(false)
+	info, err := s.ScanFile(path)
+	if err != nil {
+		t.Fatalf("ScanFile returned error: %v", err)
+	}
+
+	if !info.HasCopyright {
+		t.Fatal("expected HasCopyright to be true")
+	}
+	if info.CopyrightYear != 2015 {
+		t.Errorf("CopyrightYear = %d, want 2015", info.CopyrightYear)
+	}
+	if info.Holder != "The Vanadium Authors." {
+		t.Errorf("Holder = %q, want %q", info.Holder, "The Vanadium Authors.")
+	}
+}
+
+func TestAnalyzeCopyrightYearRangeTakesMax(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	writeFile(t, path, "// Copyright 2019-2024 Acme Inc.\npackage main\n")
+
+	s := NewScanner(false)
+	info, err := s.ScanFile(path)
+	if err != nil {
+		t.Fatalf("ScanFile returned error: %v", err)
+	}
+	if info.CopyrightYear != 2024 {
+		t.Errorf("CopyrightYear = %d, want 2024 (max of range)", info.CopyrightYear)
+	}
+}
+
+func TestAnalyzeCopyrightYearListTakesMax(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	writeFile(t, path, "// Copyright 2019, 2022, 2024 Acme Inc.\npackage main\n")
+
+	s := NewScanner(false)
+	info, err := s.ScanFile(path)
+	if err != nil {
+		t.Fatalf("ScanFile returned error: %v", err)
+	}
+	if info.CopyrightYear != 2024 {
+		t.Errorf("CopyrightYear = %d, want 2024 (max of list)", info.CopyrightYear)
+	}
+}
+
+func TestAnalyzeCopyrightSPDXIdentifier(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	writeFile(t, path, "// SPDX-License-Identifier: Apache-2.0\npackage main\n")
+
+	s := NewScanner(false)
+	info, err := s.ScanFile(path)
+	if err != nil {
+		t.Fatalf("ScanFile returned error: %v", err)
+	}
+	if info.SPDXID != "Apache-2.0" {
+		t.Errorf("SPDXID = %q, want Apache-2.0", info.SPDXID)
+	}
+}
+
+func TestHolderMismatch(t *testing.T) {
+	info := &FileInfo{Holder: "Acme Inc. All rights reserved."}
+
+	if info.HolderMismatch("") {
+		t.Error("HolderMismatch(\"\") should be false: nothing to compare")
+	}
+	if info.HolderMismatch("Acme Inc.") {
+		t.Error("HolderMismatch should be false when expected is a substring of Holder")
+	}
+	if !info.HolderMismatch("Other Corp") {
+		t.Error("HolderMismatch should be true for an unrelated company name")
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write fixture %s: %v", path, err)
+	}
+}